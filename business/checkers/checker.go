@@ -0,0 +1,24 @@
+package checkers
+
+import "github.com/kiali/kiali/models"
+
+// Checker is implemented by validations that inspect a single Istio object
+// and return the checks found on it, together with whether the object is
+// still valid overall.
+type Checker interface {
+	Check() ([]*models.IstioCheck, bool)
+}
+
+// EmptyValidValidation builds a starting point for an object's validation:
+// valid until a Checker proves otherwise.
+func EmptyValidValidation(objectName, objectType string) (models.IstioValidationKey, *models.IstioValidation) {
+	key := models.IstioValidationKey{Name: objectName, ObjectType: objectType}
+	validation := &models.IstioValidation{
+		Name:       objectName,
+		ObjectType: objectType,
+		Valid:      true,
+		Checks:     []*models.IstioCheck{},
+	}
+
+	return key, validation
+}
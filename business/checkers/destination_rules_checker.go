@@ -0,0 +1,49 @@
+package checkers
+
+import (
+	"github.com/kiali/kiali/business/checkers/destinationrules"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const DestinationRuleCheckerType = "destinationrule"
+
+// DestinationRulesChecker runs every validation that applies across the full
+// set of a namespace's DestinationRules, as well as the ones that apply to
+// each DestinationRule individually.
+type DestinationRulesChecker struct {
+	DestinationRules []kubernetes.IstioObject
+	Namespaces       []string
+}
+
+func (d DestinationRulesChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	validations = validations.MergeValidations(destinationrules.MultiMatchChecker{
+		DestinationRules: d.DestinationRules,
+		Namespaces:       d.Namespaces,
+	}.Check())
+
+	for _, dr := range d.DestinationRules {
+		validations = validations.MergeValidations(d.runChecks(dr))
+	}
+
+	return validations
+}
+
+func (d DestinationRulesChecker) runChecks(dr kubernetes.IstioObject) models.IstioValidations {
+	destinationRuleName := dr.GetObjectMeta().Name
+	key, rrValidation := EmptyValidValidation(destinationRuleName, DestinationRuleCheckerType)
+
+	enabledCheckers := []Checker{
+		destinationrules.DuplicateSubsetsChecker{DestinationRule: dr},
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
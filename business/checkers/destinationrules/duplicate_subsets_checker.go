@@ -0,0 +1,61 @@
+package destinationrules
+
+import (
+	"fmt"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// DuplicateSubsetsChecker flags a DestinationRule that declares two or more
+// subsets sharing the same name. Istio's DR merging silently drops the
+// duplicates, so validation needs to surface it explicitly.
+type DuplicateSubsetsChecker struct {
+	DestinationRule kubernetes.IstioObject
+}
+
+func (d DuplicateSubsetsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	subsets, found := d.DestinationRule.GetSpec()["subsets"]
+	if !found {
+		return validations, valid
+	}
+	subsetSlice, ok := subsets.([]interface{})
+	if !ok {
+		return validations, valid
+	}
+
+	seen := make(map[string]int)
+	reported := make(map[string]bool)
+	for i, se := range subsetSlice {
+		element, ok := se.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, found := element["name"]
+		if !found {
+			continue
+		}
+		n, ok := name.(string)
+		if !ok {
+			continue
+		}
+
+		if previous, duplicate := seen[n]; duplicate {
+			if !reported[n] {
+				check := models.Build("destinationrules.subsets.duplicate", fmt.Sprintf("spec/subsets[%d]/name", previous))
+				validations = append(validations, &check)
+				reported[n] = true
+			}
+			check := models.Build("destinationrules.subsets.duplicate", fmt.Sprintf("spec/subsets[%d]/name", i))
+			validations = append(validations, &check)
+			valid = false
+			continue
+		}
+		seen[n] = i
+	}
+
+	return validations, valid
+}
@@ -0,0 +1,54 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestDuplicatedSubsets(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1-again"), dr)
+
+	checks, valid := DuplicateSubsetsChecker{DestinationRule: dr}.Check()
+
+	assert.False(valid)
+	assert.Len(checks, 2)
+	assert.Equal("spec/subsets[0]/name", checks[0].Path)
+	assert.Equal("spec/subsets[1]/name", checks[1].Path)
+}
+
+func TestTripleDuplicatedSubsetsDoesNotReportFirstIndexTwice(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1-again"), dr)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1-yet-again"), dr)
+
+	checks, valid := DuplicateSubsetsChecker{DestinationRule: dr}.Check()
+
+	assert.False(valid)
+	assert.Len(checks, 3)
+	assert.Equal("spec/subsets[0]/name", checks[0].Path)
+	assert.Equal("spec/subsets[1]/name", checks[1].Path)
+	assert.Equal("spec/subsets[2]/name", checks[2].Path)
+}
+
+func TestNoDuplicatedSubsets(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"), dr)
+
+	checks, valid := DuplicateSubsetsChecker{DestinationRule: dr}.Check()
+
+	assert.True(valid)
+	assert.Empty(checks)
+}
@@ -0,0 +1,70 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func destinationRuleWithExportTo(namespace, name, host string, exportTo []interface{}) kubernetes.IstioObject {
+	dr := data.CreateEmptyDestinationRule(namespace, name, host)
+	if exportTo != nil {
+		dr.GetSpec()["exportTo"] = exportTo
+	}
+	return dr
+}
+
+func TestDotScopedDestinationRulesInDifferentNamespacesDoNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithExportTo("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local", []interface{}{"."}),
+		destinationRuleWithExportTo("other", "dr2", "reviews.bookinfo.svc.cluster.local", []interface{}{"."}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs, Namespaces: []string{"bookinfo", "other"}}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestWildcardVsDotScopedOnlyCollideInOwnerNamespace(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithExportTo("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local", []interface{}{"*"}),
+		destinationRuleWithExportTo("bookinfo", "dr2", "reviews.bookinfo.svc.cluster.local", []interface{}{"."}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs, Namespaces: []string{"bookinfo", "other"}}.Check()
+
+	assert.NotEmpty(validations)
+}
+
+func TestExplicitNamespaceListsIntersect(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithExportTo("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local", []interface{}{"ns1", "ns2"}),
+		destinationRuleWithExportTo("other", "dr2", "reviews.bookinfo.svc.cluster.local", []interface{}{"ns2", "ns3"}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs, Namespaces: []string{"ns1", "ns2", "ns3"}}.Check()
+
+	assert.NotEmpty(validations)
+}
+
+func TestExplicitNamespaceListsWithNoOverlapDoNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithExportTo("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local", []interface{}{"ns1"}),
+		destinationRuleWithExportTo("other", "dr2", "reviews.bookinfo.svc.cluster.local", []interface{}{"ns2"}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs, Namespaces: []string{"ns1", "ns2"}}.Check()
+
+	assert.Empty(validations)
+}
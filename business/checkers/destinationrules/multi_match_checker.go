@@ -1,6 +1,7 @@
 package destinationrules
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/kiali/kiali/kubernetes"
@@ -11,6 +12,11 @@ const DestinationRulesCheckerType = "destinationrule"
 
 type MultiMatchChecker struct {
 	DestinationRules []kubernetes.IstioObject
+	// Namespaces bounds which explicit namespace names in an exportTo list
+	// are meaningful to intersect against. It has no effect on "*" (absent
+	// exportTo, or an explicit "*" entry), which is always visible
+	// everywhere, matching Istio's own default.
+	Namespaces []string
 }
 
 type Host struct {
@@ -24,57 +30,197 @@ type subset struct {
 	RuleName string
 }
 
-// Check validates that no two destinationRules target the same host+subset combination
+// Hostname is a host as it appears in a DestinationRule's spec.host, with
+// Istio's wildcard matching semantics layered on top of plain equality.
+type Hostname string
+
+// Matches reports whether h and other refer to overlapping sets of hosts.
+// "*" matches anything, "*.foo.com" matches "bar.foo.com" (but not "foo.com"
+// itself), and any other hostname only matches itself.
+func (h Hostname) Matches(other Hostname) bool {
+	if h == "*" || other == "*" || h == other {
+		return true
+	}
+	return isWildcardMatch(string(h), string(other)) || isWildcardMatch(string(other), string(h))
+}
+
+// isWildcardMatch reports whether name is covered by wildcard, where wildcard
+// is expected to be of the form "*.suffix".
+func isWildcardMatch(wildcard, name string) bool {
+	if !strings.HasPrefix(wildcard, "*.") {
+		return false
+	}
+	return strings.HasSuffix(name, wildcard[1:])
+}
+
+// hostSubsets pairs a seen Hostname with the subsets declared for it, keeping
+// insertion order so later DestinationRules are checked against every host
+// seen so far, not just an exact map lookup.
+type hostSubsets struct {
+	Hostname      Hostname
+	RuleName      string
+	Subsets       map[string]string
+	TrafficPolicy interface{}
+	Visibility    map[string]bool
+}
+
+// allNamespaces is the sentinel visibility entry meaning "visible from every
+// namespace", regardless of what namespace universe the caller knows about.
+const allNamespaces = "*"
+
+// visibilityIntersects reports whether there's at least one namespace that
+// can see both a and b.
+func visibilityIntersects(a, b map[string]bool) bool {
+	if a[allNamespaces] || b[allNamespaces] {
+		return true
+	}
+	for ns := range a {
+		if b[ns] {
+			return true
+		}
+	}
+	return false
+}
+
+// computeVisibility parses a DestinationRule's exportTo into the set of
+// namespaces it's visible from: "*" (the default when exportTo is absent)
+// is visible everywhere, "." means only the DR's own namespace, and anything
+// else is taken as an explicit namespace name - intersected against universe
+// when the caller provided one, since an explicit namespace the checker
+// doesn't know about can't ever be the "seen" side of a collision.
+func computeVisibility(dr kubernetes.IstioObject, universe []string) map[string]bool {
+	own := dr.GetObjectMeta().Namespace
+	entries := []string{"*"}
+
+	if exportTo, found := dr.GetSpec()["exportTo"]; found {
+		if list, ok := exportTo.([]interface{}); ok && len(list) > 0 {
+			entries = entries[:0]
+			for _, e := range list {
+				if s, ok := e.(string); ok {
+					entries = append(entries, s)
+				}
+			}
+		}
+	}
+
+	var known map[string]bool
+	if len(universe) > 0 {
+		known = make(map[string]bool, len(universe))
+		for _, ns := range universe {
+			known[ns] = true
+		}
+	}
+
+	visibility := make(map[string]bool)
+	for _, e := range entries {
+		switch e {
+		case "*":
+			visibility[allNamespaces] = true
+		case ".":
+			visibility[own] = true
+		default:
+			if known != nil && !known[e] {
+				continue
+			}
+			visibility[e] = true
+		}
+	}
+	return visibility
+}
+
+// Check validates that no two destinationRules target overlapping hosts with
+// overlapping subsets.
 func (m MultiMatchChecker) Check() models.IstioValidations {
 	validations := models.IstioValidations{}
 
-	// Equality search is: [fqdn][subset]
-	seenHostSubsets := make(map[string]map[string]string)
+	var seenHostSubsets []hostSubsets
 
 	for _, dr := range m.DestinationRules {
-		if host, ok := dr.GetSpec()["host"]; ok {
-			destinationRulesName := dr.GetObjectMeta().Name
-			if dHost, ok := host.(string); ok {
-				fqdn := FormatHostnameForPrefixSearch(dHost, dr.GetObjectMeta().Namespace, dr.GetObjectMeta().ClusterName)
-
-				// Skip DR validation if it enables mTLS either namespace or mesh-wide
-				if enablesNonLocalmTLS(dr, fqdn) {
-					continue
-				}
+		host, ok := dr.GetSpec()["host"]
+		if !ok {
+			continue
+		}
+		dHost, ok := host.(string)
+		if !ok {
+			continue
+		}
 
-				foundSubsets := extractSubsets(dr, destinationRulesName)
+		destinationRulesName := dr.GetObjectMeta().Name
+		fqdn := FormatHostnameForPrefixSearch(dHost, dr.GetObjectMeta().Namespace, dr.GetObjectMeta().ClusterName)
 
-				if fqdn.Service == "*" {
-					// We need to check the matching subsets from all hosts now
-					for _, h := range seenHostSubsets {
-						checkCollisions(validations, destinationRulesName, foundSubsets, h)
-					}
-					// We add * later
-				}
-				// Search "*" first and then exact name
-				if previous, found := seenHostSubsets["*"]; found {
-					// Need to check subsets of "*"
-					checkCollisions(validations, destinationRulesName, foundSubsets, previous)
-				}
+		// Skip DR validation if it enables mTLS either namespace or mesh-wide
+		if enablesNonLocalmTLS(dr, fqdn) {
+			continue
+		}
 
-				if previous, found := seenHostSubsets[fqdn.Service]; found {
-					// Host found, need to check underlying subsets
-					checkCollisions(validations, destinationRulesName, foundSubsets, previous)
-				}
-				// Nothing threw an error, so add these
-				if _, found := seenHostSubsets[fqdn.Service]; !found {
-					seenHostSubsets[fqdn.Service] = make(map[string]string)
-				}
-				for _, s := range foundSubsets {
-					seenHostSubsets[fqdn.Service][s.Name] = destinationRulesName
-				}
+		foundSubsets := extractSubsets(dr, destinationRulesName)
+		hostname := CanonicalHostname(dHost, fqdn)
+		trafficPolicy := dr.GetSpec()["trafficPolicy"]
+		visibility := computeVisibility(dr, m.Namespaces)
+
+		for _, seen := range seenHostSubsets {
+			// Matches is symmetric, but check both directions explicitly so
+			// this keeps working if that symmetry ever changes.
+			if !(hostname.Matches(seen.Hostname) || seen.Hostname.Matches(hostname)) {
+				continue
+			}
+			// Two DRs invisible to each other's namespaces can't actually
+			// collide, no matter how their hosts and subsets overlap.
+			if !visibilityIntersects(visibility, seen.Visibility) {
+				continue
+			}
+			checkCollisions(validations, destinationRulesName, foundSubsets, seen.Subsets)
+
+			// Istio only merges trafficPolicy across DRs for the exact same
+			// host, not wildcard-overlapping ones, so gate this narrower
+			// than the host+subset collision check above.
+			if hostname == seen.Hostname {
+				checkTrafficPolicyConflict(validations, destinationRulesName, seen.RuleName, trafficPolicy, seen.TrafficPolicy)
 			}
 		}
+
+		seenHostSubsets = append(seenHostSubsets, hostSubsets{
+			Hostname:      hostname,
+			RuleName:      destinationRulesName,
+			Subsets:       subsetsByName(foundSubsets),
+			TrafficPolicy: trafficPolicy,
+			Visibility:    visibility,
+		})
 	}
 
 	return validations
 }
 
+// defaultClusterSuffix is Istio's own default for a service's cluster-local
+// domain suffix, used to normalize hosts that didn't specify one so a short
+// name and its equivalent FQDN canonicalize to the same Hostname.
+const defaultClusterSuffix = "svc.cluster.local"
+
+// CanonicalHostname builds the Hostname used for collision matching. A bare
+// "*" or an already-wildcarded host (e.g. "*.foo.com") is kept as written,
+// since those aren't cluster-local short names. Anything else is expanded to
+// its full FQDN, defaulting a missing cluster suffix the same way Istio
+// does, so that "reviews" and "reviews.bookinfo.svc.cluster.local" (and
+// same-named services in different namespaces) canonicalize consistently.
+func CanonicalHostname(dHost string, fqdn Host) Hostname {
+	if dHost == "*" || strings.HasPrefix(dHost, "*.") {
+		return Hostname(dHost)
+	}
+	cluster := fqdn.Cluster
+	if cluster == "" {
+		cluster = defaultClusterSuffix
+	}
+	return Hostname(fqdn.Service + "." + fqdn.Namespace + "." + cluster)
+}
+
+func subsetsByName(foundSubsets []subset) map[string]string {
+	subsets := make(map[string]string, len(foundSubsets))
+	for _, s := range foundSubsets {
+		subsets[s.Name] = s.RuleName
+	}
+	return subsets
+}
+
 func enablesNonLocalmTLS(dr kubernetes.IstioObject, fdqn Host) bool {
 	if fdqn.Service != "*" {
 		return false
@@ -139,23 +285,57 @@ func checkCollisions(validations models.IstioValidations, destinationRulesName s
 }
 
 func addError(validations models.IstioValidations, destinationRuleNames []string) models.IstioValidations {
+	return addCheck(validations, "destinationrules.multimatch", "spec/host", destinationRuleNames)
+}
+
+// checkTrafficPolicyConflict warns when two DestinationRules targeting the
+// same host declare different top-level trafficPolicy, since Istio's merge
+// keeps only one of them and silently drops the other. A DR that omits
+// trafficPolicy entirely is a pure additive merge and isn't flagged.
+func checkTrafficPolicyConflict(validations models.IstioValidations, currentName, previousName string, current, previous interface{}) {
+	if current == nil || previous == nil {
+		return
+	}
+	if reflect.DeepEqual(current, previous) {
+		return
+	}
+	addCheck(validations, "destinationrules.trafficpolicy.conflict", "spec/trafficPolicy", []string{currentName, previousName})
+}
+
+func addCheck(validations models.IstioValidations, checkId, path string, destinationRuleNames []string) models.IstioValidations {
 	for _, destinationRuleName := range destinationRuleNames {
 		key := models.IstioValidationKey{Name: destinationRuleName, ObjectType: DestinationRulesCheckerType}
-		checks := models.Build("destinationrules.multimatch", "spec/host")
-		rrValidation := &models.IstioValidation{
+
+		if existing, found := validations[key]; found {
+			if hasCheck(existing.Checks, checkId, path) {
+				continue
+			}
+			check := models.Build(checkId, path)
+			existing.Checks = append(existing.Checks, &check)
+			continue
+		}
+
+		check := models.Build(checkId, path)
+		validations[key] = &models.IstioValidation{
 			Name:       destinationRuleName,
 			ObjectType: DestinationRulesCheckerType,
 			Valid:      true,
-			Checks: []*models.IstioCheck{
-				&checks,
-			},
+			Checks:     []*models.IstioCheck{&check},
 		}
+	}
+	return validations
+}
 
-		if _, exists := validations[key]; !exists {
-			validations.MergeValidations(models.IstioValidations{key: rrValidation})
+// hasCheck reports whether checks already contains a check for the same
+// checkId and path, so addCheck doesn't pile up identical checks when the
+// same pair of DestinationRules collides on more than one subset.
+func hasCheck(checks []*models.IstioCheck, checkId, path string) bool {
+	for _, c := range checks {
+		if c.Path == path && c.Code == checkId {
+			return true
 		}
 	}
-	return validations
+	return false
 }
 
 // FormatHostnameForPrefixSearch formats given DR host information to a FQDN format
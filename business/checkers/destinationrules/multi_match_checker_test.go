@@ -0,0 +1,148 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestMultiMatchHostSubsetCollision(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews"),
+		data.CreateEmptyDestinationRule("bookinfo", "dr2", "reviews"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+	for _, validation := range validations {
+		assert.Len(validation.Checks, 1)
+	}
+}
+
+func TestMultiMatchOverlappingSubsetsReportOneCheckPerRule(t *testing.T) {
+	assert := assert.New(t)
+
+	dr1 := data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr1)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"), dr1)
+	dr2 := data.CreateEmptyDestinationRule("bookinfo", "dr2", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr2)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"), dr2)
+
+	validations := MultiMatchChecker{DestinationRules: []kubernetes.IstioObject{dr1, dr2}}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+	for _, validation := range validations {
+		assert.Len(validation.Checks, 1)
+	}
+}
+
+func TestPartialWildcardMatchesSubdomain(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "*.foo.com"),
+		data.CreateEmptyDestinationRule("bookinfo", "dr2", "bar.foo.com"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+}
+
+func TestPartialWildcardDoesNotMatchItsOwnSuffix(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "*.foo.com"),
+		data.CreateEmptyDestinationRule("bookinfo", "dr2", "foo.com"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestFullWildcardMatchesEverything(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "*"),
+		data.CreateEmptyDestinationRule("bookinfo", "dr2", "reviews"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+}
+
+func TestShortNameAndFQDNOfSameServiceCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews"),
+		data.CreateEmptyDestinationRule("bookinfo", "dr2", "reviews.bookinfo.svc.cluster.local"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+}
+
+func TestDefaultExportToCollidesAcrossNamespacesWithoutUniverse(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local"),
+		data.CreateEmptyDestinationRule("other", "dr2", "reviews.bookinfo.svc.cluster.local"),
+	}
+
+	// No Namespaces universe is plumbed in, but the default (absent)
+	// exportTo is still visible everywhere, matching Istio's own default.
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.NotEmpty(validations)
+	assert.Equal(2, len(validations))
+}
+
+func TestExplicitExportToOutsideUniverseDoesNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	dr1 := data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local")
+	dr1.GetSpec()["exportTo"] = []interface{}{"other"}
+	dr2 := data.CreateEmptyDestinationRule("other", "dr2", "reviews.bookinfo.svc.cluster.local")
+	dr2.GetSpec()["exportTo"] = []interface{}{"third"}
+
+	// "third" isn't part of the namespace universe the checker was given,
+	// so dr2 ends up visible nowhere and can't intersect with dr1.
+	validations := MultiMatchChecker{
+		DestinationRules: []kubernetes.IstioObject{dr1, dr2},
+		Namespaces:       []string{"bookinfo", "other"},
+	}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestSameServiceNameDifferentNamespaceDoesNotCollide(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		data.CreateEmptyDestinationRule("bookinfo", "dr1", "reviews.bookinfo.svc.cluster.local"),
+		data.CreateEmptyDestinationRule("other", "dr2", "reviews.other.svc.cluster.local"),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Empty(validations)
+}
@@ -0,0 +1,163 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func destinationRuleWithTrafficPolicy(namespace, name, host string, trafficPolicy map[string]interface{}) kubernetes.IstioObject {
+	dr := data.CreateEmptyDestinationRule(namespace, name, host)
+	if trafficPolicy != nil {
+		dr.GetSpec()["trafficPolicy"] = trafficPolicy
+	}
+	return dr
+}
+
+// assertHasCheckAtPath fails the test unless some validation carries a check
+// at the given path, so a trafficPolicy conflict can't hide behind a
+// multimatch check that happens to land on the same DestinationRule.
+func assertHasCheckAtPath(assert *assert.Assertions, validations models.IstioValidations, path string) {
+	for _, validation := range validations {
+		for _, check := range validation.Checks {
+			if check.Path == path {
+				return
+			}
+		}
+	}
+	assert.Fail("expected a check", "no check found at path %q", path)
+}
+
+func TestConflictingLoadBalancer(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", map[string]interface{}{
+			"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"},
+		}),
+		destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", map[string]interface{}{
+			"loadBalancer": map[string]interface{}{"simple": "LEAST_CONN"},
+		}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Len(validations, 2)
+	assertHasCheckAtPath(assert, validations, "spec/trafficPolicy")
+}
+
+func TestConflictingConnectionPool(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", map[string]interface{}{
+			"connectionPool": map[string]interface{}{"tcp": map[string]interface{}{"maxConnections": float64(10)}},
+		}),
+		destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", map[string]interface{}{
+			"connectionPool": map[string]interface{}{"tcp": map[string]interface{}{"maxConnections": float64(100)}},
+		}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Len(validations, 2)
+	assertHasCheckAtPath(assert, validations, "spec/trafficPolicy")
+}
+
+func TestConflictingTLSMode(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", map[string]interface{}{
+			"tls": map[string]interface{}{"mode": "SIMPLE"},
+		}),
+		destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", map[string]interface{}{
+			"tls": map[string]interface{}{"mode": "MUTUAL"},
+		}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Len(validations, 2)
+	assertHasCheckAtPath(assert, validations, "spec/trafficPolicy")
+}
+
+func TestConflictingOutlierDetection(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", map[string]interface{}{
+			"outlierDetection": map[string]interface{}{"consecutiveErrors": float64(5)},
+		}),
+		destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", map[string]interface{}{
+			"outlierDetection": map[string]interface{}{"consecutiveErrors": float64(10)},
+		}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Len(validations, 2)
+	assertHasCheckAtPath(assert, validations, "spec/trafficPolicy")
+}
+
+func TestIdenticalTrafficPolicyDoesNotConflict(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := map[string]interface{}{"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"}}
+	dr1 := destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", policy)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr1)
+	dr2 := destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", policy)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"), dr2)
+
+	// Disjoint subsets so no host+subset collision fires and the
+	// trafficPolicy comparison is isolated.
+	drs := []kubernetes.IstioObject{dr1, dr2}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestOmittedTrafficPolicyIsAdditiveMerge(t *testing.T) {
+	assert := assert.New(t)
+
+	dr1 := destinationRuleWithTrafficPolicy("bookinfo", "dr1", "reviews", map[string]interface{}{
+		"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"},
+	})
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr1)
+	dr2 := destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", nil)
+	data.AddSubsetToDestinationRule(data.CreateSubset("v2", "v2"), dr2)
+
+	// Disjoint subsets so no host+subset collision fires and the
+	// trafficPolicy comparison is isolated.
+	drs := []kubernetes.IstioObject{dr1, dr2}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestTrafficPolicyConflictOnlyAppliesToExactHostMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	drs := []kubernetes.IstioObject{
+		destinationRuleWithTrafficPolicy("bookinfo", "dr1", "*", map[string]interface{}{
+			"loadBalancer": map[string]interface{}{"simple": "ROUND_ROBIN"},
+		}),
+		destinationRuleWithTrafficPolicy("bookinfo", "dr2", "reviews", map[string]interface{}{
+			"loadBalancer": map[string]interface{}{"simple": "LEAST_CONN"},
+		}),
+	}
+
+	validations := MultiMatchChecker{DestinationRules: drs}.Check()
+
+	for _, validation := range validations {
+		for _, check := range validation.Checks {
+			assert.NotEqual("spec/trafficPolicy", check.Path)
+		}
+	}
+}
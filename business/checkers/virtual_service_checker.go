@@ -0,0 +1,35 @@
+package checkers
+
+import (
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kiali/kiali/business/checkers/virtualservices"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// VirtualServiceChecker runs every validation that needs the full picture of
+// a namespace's VirtualServices, Gateway API routes and DestinationRules
+// together.
+type VirtualServiceChecker struct {
+	DestinationRules []kubernetes.IstioObject
+	VirtualServices  []kubernetes.IstioObject
+	HTTPRoutes       []*k8s_networking_v1beta1.HTTPRoute
+	TLSRoutes        []*k8s_networking_v1alpha2.TLSRoute
+	TCPRoutes        []*k8s_networking_v1alpha2.TCPRoute
+}
+
+func (v VirtualServiceChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	validations = validations.MergeValidations(virtualservices.SubsetPresenceChecker{
+		DestinationRules: v.DestinationRules,
+		VirtualServices:  v.VirtualServices,
+		HTTPRoutes:       v.HTTPRoutes,
+		TLSRoutes:        v.TLSRoutes,
+		TCPRoutes:        v.TCPRoutes,
+	}.Check())
+
+	return validations
+}
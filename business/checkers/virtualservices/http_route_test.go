@@ -0,0 +1,91 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func httpRouteWithSubset(namespace, name, backendHost, subset string) *k8s_networking_v1beta1.HTTPRoute {
+	filters := []k8s_networking_v1beta1.HTTPRouteFilter{}
+	if subset != "" {
+		filters = append(filters, k8s_networking_v1beta1.HTTPRouteFilter{
+			Type: k8s_networking_v1beta1.HTTPRouteFilterExtensionRef,
+			ExtensionRef: &k8s_networking_v1beta1.LocalObjectReference{
+				Kind: "istio.io/subset",
+				Name: k8s_networking_v1beta1.ObjectName(subset),
+			},
+		})
+	}
+
+	return &k8s_networking_v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: k8s_networking_v1beta1.HTTPRouteSpec{
+			Rules: []k8s_networking_v1beta1.HTTPRouteRule{
+				{
+					BackendRefs: []k8s_networking_v1beta1.HTTPBackendRef{
+						{
+							BackendRef: k8s_networking_v1beta1.BackendRef{
+								BackendObjectReference: k8s_networking_v1beta1.BackendObjectReference{
+									Name: k8s_networking_v1beta1.ObjectName(backendHost),
+								},
+							},
+							Filters: filters,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestHTTPRouteSubsetPresent(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		HTTPRoutes:       []*k8s_networking_v1beta1.HTTPRoute{httpRouteWithSubset("bookinfo", "reviews-route", "reviews", "v1")},
+	}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestHTTPRouteSubsetMissing(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		HTTPRoutes:       []*k8s_networking_v1beta1.HTTPRoute{httpRouteWithSubset("bookinfo", "reviews-route", "reviews", "v2")},
+	}.Check()
+
+	assert.NotEmpty(validations)
+}
+
+func TestVirtualServiceAndHTTPRouteShareDestinationRuleValidation(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	vs := data.CreateVirtualService()
+	data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v2", 100), vs)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		VirtualServices:  []kubernetes.IstioObject{vs},
+		HTTPRoutes:       []*k8s_networking_v1beta1.HTTPRoute{httpRouteWithSubset("bookinfo", "reviews-route", "reviews", "v1")},
+	}.Check()
+
+	assert.Len(validations, 1)
+}
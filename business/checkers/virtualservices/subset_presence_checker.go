@@ -0,0 +1,149 @@
+package virtualservices
+
+import (
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/kiali/kiali/business/checkers/destinationrules"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const VirtualServiceCheckerType = "virtualservice"
+const HTTPRouteCheckerType = "httproute"
+const TLSRouteCheckerType = "tlsroute"
+const TCPRouteCheckerType = "tcproute"
+
+// knownHost pairs a DestinationRule's canonical Hostname with the subset
+// names it declares, so a route destination can be matched against it the
+// same wildcard-aware way MultiMatchChecker matches DR hosts against each
+// other.
+type knownHost struct {
+	Hostname destinationrules.Hostname
+	Subsets  map[string]bool
+}
+
+// SubsetPresenceChecker validates that every subset a route forwards traffic
+// to is actually declared by some DestinationRule targeting that host. It
+// understands Istio VirtualServices and the Gateway API HTTPRoute, TLSRoute
+// and TCPRoute kinds, since Istio resolves subsets the same way regardless of
+// which API a route was authored in.
+type SubsetPresenceChecker struct {
+	DestinationRules []kubernetes.IstioObject
+	VirtualServices  []kubernetes.IstioObject
+	HTTPRoutes       []*k8s_networking_v1beta1.HTTPRoute
+	TLSRoutes        []*k8s_networking_v1alpha2.TLSRoute
+	TCPRoutes        []*k8s_networking_v1alpha2.TCPRoute
+}
+
+func (s SubsetPresenceChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+	knownHosts := s.buildKnownHosts()
+
+	for _, vs := range s.VirtualServices {
+		s.checkRouteDestinations(validations, vs.GetObjectMeta().Name, VirtualServiceCheckerType,
+			kubernetes.RouteDestinationsFromVirtualService(vs), knownHosts)
+	}
+
+	for _, route := range s.HTTPRoutes {
+		s.checkRouteDestinations(validations, route.Name, HTTPRouteCheckerType,
+			kubernetes.RouteDestinationsFromHTTPRoute(route), knownHosts)
+	}
+
+	for _, route := range s.TLSRoutes {
+		s.checkRouteDestinations(validations, route.Name, TLSRouteCheckerType,
+			kubernetes.RouteDestinationsFromTLSRoute(route), knownHosts)
+	}
+
+	for _, route := range s.TCPRoutes {
+		s.checkRouteDestinations(validations, route.Name, TCPRouteCheckerType,
+			kubernetes.RouteDestinationsFromTCPRoute(route), knownHosts)
+	}
+
+	return validations
+}
+
+func (s SubsetPresenceChecker) checkRouteDestinations(validations models.IstioValidations, name, objectType string, destinations []kubernetes.RouteDestination, knownHosts []knownHost) {
+	checks := make([]*models.IstioCheck, 0)
+
+	for _, destination := range destinations {
+		if destination.Subset == "" {
+			// No subset referenced, nothing to validate.
+			continue
+		}
+
+		fqdn := destinationrules.FormatHostnameForPrefixSearch(destination.Host, destination.Namespace, "")
+		hostname := destinationrules.CanonicalHostname(destination.Host, fqdn)
+
+		if check, ok := checkSubsetPresence(hostname, destination.Subset, destination.Path, knownHosts); !ok {
+			checks = append(checks, check)
+		}
+	}
+
+	if len(checks) == 0 {
+		return
+	}
+
+	key := models.IstioValidationKey{Name: name, ObjectType: objectType}
+	validations.MergeValidations(models.IstioValidations{
+		key: &models.IstioValidation{
+			Name:       name,
+			ObjectType: objectType,
+			Valid:      false,
+			Checks:     checks,
+		},
+	})
+}
+
+func (s SubsetPresenceChecker) buildKnownHosts() []knownHost {
+	knownHosts := make([]knownHost, 0, len(s.DestinationRules))
+
+	for _, dr := range s.DestinationRules {
+		host, ok := dr.GetSpec()["host"]
+		if !ok {
+			continue
+		}
+		dHost, ok := host.(string)
+		if !ok {
+			continue
+		}
+
+		fqdn := destinationrules.FormatHostnameForPrefixSearch(dHost, dr.GetObjectMeta().Namespace, dr.GetObjectMeta().ClusterName)
+		hostname := destinationrules.CanonicalHostname(dHost, fqdn)
+
+		subsets := make(map[string]bool)
+		if rawSubsets, found := dr.GetSpec()["subsets"]; found {
+			if subsetSlice, ok := rawSubsets.([]interface{}); ok {
+				for _, se := range subsetSlice {
+					if element, ok := se.(map[string]interface{}); ok {
+						if name, found := element["name"]; found {
+							if n, ok := name.(string); ok {
+								subsets[n] = true
+							}
+						}
+					}
+				}
+			}
+		}
+
+		knownHosts = append(knownHosts, knownHost{Hostname: hostname, Subsets: subsets})
+	}
+
+	return knownHosts
+}
+
+func checkSubsetPresence(hostname destinationrules.Hostname, subset, path string, knownHosts []knownHost) (*models.IstioCheck, bool) {
+	for _, known := range knownHosts {
+		if !hostname.Matches(known.Hostname) && !known.Hostname.Matches(hostname) {
+			continue
+		}
+		if known.Subsets[subset] {
+			return nil, true
+		}
+	}
+
+	// Either the host isn't declared by any DestinationRule, or it is but
+	// doesn't declare this subset - either way the route can't resolve.
+	check := models.Build("virtualservice.subsetpresent", path)
+	return &check, false
+}
@@ -0,0 +1,58 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestSubsetPresent(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	vs := data.CreateVirtualService()
+	data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 100), vs)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		VirtualServices:  []kubernetes.IstioObject{vs},
+	}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestSubsetMissingHostPresent(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	vs := data.CreateVirtualService()
+	data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v2", 100), vs)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		VirtualServices:  []kubernetes.IstioObject{vs},
+	}.Check()
+
+	assert.NotEmpty(validations)
+}
+
+func TestSubsetHostMissingEntirely(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.CreateVirtualService()
+	data.AddRoutesToVirtualService("http", data.CreateRoute("reviews", "v1", 100), vs)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{},
+		VirtualServices:  []kubernetes.IstioObject{vs},
+	}.Check()
+
+	assert.NotEmpty(validations)
+}
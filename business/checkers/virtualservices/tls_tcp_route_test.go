@@ -0,0 +1,82 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func tlsRouteToBackend(namespace, name, backendHost string) *k8s_networking_v1alpha2.TLSRoute {
+	return &k8s_networking_v1alpha2.TLSRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: k8s_networking_v1alpha2.TLSRouteSpec{
+			Rules: []k8s_networking_v1alpha2.TLSRouteRule{
+				{
+					BackendRefs: []k8s_networking_v1alpha2.BackendRef{
+						{
+							BackendObjectReference: k8s_networking_v1alpha2.BackendObjectReference{
+								Name: k8s_networking_v1alpha2.ObjectName(backendHost),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func tcpRouteToBackend(namespace, name, backendHost string) *k8s_networking_v1alpha2.TCPRoute {
+	return &k8s_networking_v1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: k8s_networking_v1alpha2.TCPRouteSpec{
+			Rules: []k8s_networking_v1alpha2.TCPRouteRule{
+				{
+					BackendRefs: []k8s_networking_v1alpha2.BackendRef{
+						{
+							BackendObjectReference: k8s_networking_v1alpha2.BackendObjectReference{
+								Name: k8s_networking_v1alpha2.ObjectName(backendHost),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TLSRoute and TCPRoute rules have no filters, so there's never a subset to
+// validate - these just confirm the new route kinds are plumbed through
+// without panicking and report nothing for a backend whose host has a
+// DestinationRule but which references no subset.
+func TestTLSRouteHasNoSubsetToValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		TLSRoutes:        []*k8s_networking_v1alpha2.TLSRoute{tlsRouteToBackend("bookinfo", "reviews-route", "reviews")},
+	}.Check()
+
+	assert.Empty(validations)
+}
+
+func TestTCPRouteHasNoSubsetToValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews-dr", "reviews")
+	data.AddSubsetToDestinationRule(data.CreateSubset("v1", "v1"), dr)
+
+	validations := SubsetPresenceChecker{
+		DestinationRules: []kubernetes.IstioObject{dr},
+		TCPRoutes:        []*k8s_networking_v1alpha2.TCPRoute{tcpRouteToBackend("bookinfo", "reviews-route", "reviews")},
+	}.Check()
+
+	assert.Empty(validations)
+}
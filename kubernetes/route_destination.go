@@ -0,0 +1,182 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// istioSubsetFilterExtension is the Gateway API extension filter Istio uses
+// to attach a DestinationRule subset to an HTTPRoute backendRef, since the
+// Gateway API spec itself has no native notion of subsets.
+const istioSubsetFilterExtension = "istio.io/subset"
+
+// RouteDestination is the host+subset a single route entry forwards traffic
+// to, normalized so it doesn't matter whether it came from an Istio
+// VirtualService or a Gateway API HTTPRoute/TLSRoute/TCPRoute.
+type RouteDestination struct {
+	Host      string
+	Subset    string
+	Namespace string
+	// Path is where a validation finding should be anchored, e.g.
+	// "spec/http[0]/route[0]/destination/subset".
+	Path string
+}
+
+// RouteDestinationsFromVirtualService walks a VirtualService's http/tcp/tls
+// routes and returns a RouteDestination for every destination declared.
+func RouteDestinationsFromVirtualService(vs IstioObject) []RouteDestination {
+	destinations := make([]RouteDestination, 0)
+	namespace := vs.GetObjectMeta().Namespace
+
+	for _, routeField := range []string{"http", "tcp", "tls"} {
+		routes, found := vs.GetSpec()[routeField]
+		if !found {
+			continue
+		}
+		routeSlice, ok := routes.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for i, r := range routeSlice {
+			route, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			rawRoute, found := route["route"]
+			if !found {
+				continue
+			}
+			destSlice, ok := rawRoute.([]interface{})
+			if !ok {
+				continue
+			}
+
+			for j, d := range destSlice {
+				destWrapper, ok := d.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				destination, found := destWrapper["destination"]
+				if !found {
+					continue
+				}
+				destMap, ok := destination.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				host, ok := destMap["host"].(string)
+				if !ok {
+					continue
+				}
+				subset, _ := destMap["subset"].(string)
+
+				destinations = append(destinations, RouteDestination{
+					Host:      host,
+					Subset:    subset,
+					Namespace: namespace,
+					Path:      fmt.Sprintf("spec/%s[%d]/route[%d]/destination/subset", routeField, i, j),
+				})
+			}
+		}
+	}
+
+	return destinations
+}
+
+// RouteDestinationsFromHTTPRoute walks a Gateway API HTTPRoute's
+// rules[].backendRefs and returns a RouteDestination for each one, reading
+// the DestinationRule subset off Istio's subset filter extension when
+// present.
+func RouteDestinationsFromHTTPRoute(route *k8s_networking_v1beta1.HTTPRoute) []RouteDestination {
+	destinations := make([]RouteDestination, 0)
+	namespace := route.Namespace
+
+	for i, rule := range route.Spec.Rules {
+		ruleSubset := subsetFromFilters(rule.Filters)
+
+		for j, backendRef := range rule.BackendRefs {
+			subset := ruleSubset
+			if s := subsetFromFilters(backendRef.Filters); s != "" {
+				subset = s
+			}
+
+			backendNamespace := namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				backendNamespace = string(*backendRef.Namespace)
+			}
+
+			destinations = append(destinations, RouteDestination{
+				Host:      string(backendRef.Name),
+				Subset:    subset,
+				Namespace: backendNamespace,
+				Path:      fmt.Sprintf("spec/rules[%d]/backendRefs[%d]", i, j),
+			})
+		}
+	}
+
+	return destinations
+}
+
+func subsetFromFilters(filters []k8s_networking_v1beta1.HTTPRouteFilter) string {
+	for _, filter := range filters {
+		if filter.ExtensionRef == nil || string(filter.ExtensionRef.Kind) != istioSubsetFilterExtension {
+			continue
+		}
+		return string(filter.ExtensionRef.Name)
+	}
+	return ""
+}
+
+// RouteDestinationsFromTLSRoute walks a Gateway API TLSRoute's
+// rules[].backendRefs and returns a RouteDestination for each one. TLSRoute
+// rules have no filters, so unlike HTTPRoute there's no way to attach a
+// DestinationRule subset - Subset is always left empty.
+func RouteDestinationsFromTLSRoute(route *k8s_networking_v1alpha2.TLSRoute) []RouteDestination {
+	destinations := make([]RouteDestination, 0)
+	namespace := route.Namespace
+
+	for i, rule := range route.Spec.Rules {
+		for j, backendRef := range rule.BackendRefs {
+			backendNamespace := namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				backendNamespace = string(*backendRef.Namespace)
+			}
+
+			destinations = append(destinations, RouteDestination{
+				Host:      string(backendRef.Name),
+				Namespace: backendNamespace,
+				Path:      fmt.Sprintf("spec/rules[%d]/backendRefs[%d]", i, j),
+			})
+		}
+	}
+
+	return destinations
+}
+
+// RouteDestinationsFromTCPRoute walks a Gateway API TCPRoute's
+// rules[].backendRefs and returns a RouteDestination for each one. Like
+// TLSRoute, TCPRoute rules have no filters, so Subset is always left empty.
+func RouteDestinationsFromTCPRoute(route *k8s_networking_v1alpha2.TCPRoute) []RouteDestination {
+	destinations := make([]RouteDestination, 0)
+	namespace := route.Namespace
+
+	for i, rule := range route.Spec.Rules {
+		for j, backendRef := range rule.BackendRefs {
+			backendNamespace := namespace
+			if backendRef.Namespace != nil && *backendRef.Namespace != "" {
+				backendNamespace = string(*backendRef.Namespace)
+			}
+
+			destinations = append(destinations, RouteDestination{
+				Host:      string(backendRef.Name),
+				Namespace: backendNamespace,
+				Path:      fmt.Sprintf("spec/rules[%d]/backendRefs[%d]", i, j),
+			})
+		}
+	}
+
+	return destinations
+}